@@ -0,0 +1,183 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CacheSubnetGroupParameters define the desired state of an AWS ElastiCache
+// Cache Subnet Group.
+type CacheSubnetGroupParameters struct {
+	// Region is the region you'd like your CacheSubnetGroup to be created in.
+	Region string `json:"region"`
+
+	// Description for the cache subnet group.
+	Description string `json:"description"`
+
+	// SubnetIDs is a list of VPC subnet IDs for the cache subnet group.
+	SubnetIDs []string `json:"subnetIds"`
+
+	// DriftDetectionPolicy controls how differences between this spec and
+	// the observed AWS CacheSubnetGroup are handled. Defaults to Enforce.
+	// +kubebuilder:validation:Enum=Enforce;DetectOnly;Ignore
+	// +optional
+	DriftDetectionPolicy DriftDetectionPolicy `json:"driftDetectionPolicy,omitempty"`
+
+	// Tags to apply to the cache subnet group. The provider always adds
+	// its own crossplane-kind and crossplane-name tags, plus a
+	// crossplane-providerconfig tag when a ProviderConfig reference is
+	// set, on top of these, overriding any entry here with the same key.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A Tag is a key-value metadata pair that can be attached to an AWS
+// ElastiCache resource.
+type Tag struct {
+	// Key of the tag.
+	Key string `json:"key"`
+
+	// Value of the tag.
+	Value string `json:"value"`
+}
+
+// A DriftDetectionPolicy determines how a CacheSubnetGroupParameters diff
+// from the observed AWS resource is handled.
+type DriftDetectionPolicy string
+
+const (
+	// DriftDetectionEnforce reconciles any detected drift by calling
+	// ModifyCacheSubnetGroup. This is the default when unset.
+	DriftDetectionEnforce DriftDetectionPolicy = "Enforce"
+
+	// DriftDetectionDetectOnly never modifies the external resource.
+	// Detected drift is instead surfaced via the Drifted condition and the
+	// observation's drift field.
+	DriftDetectionDetectOnly DriftDetectionPolicy = "DetectOnly"
+
+	// DriftDetectionIgnore behaves like DriftDetectionEnforce except that
+	// the Description field is excluded from drift comparison.
+	DriftDetectionIgnore DriftDetectionPolicy = "Ignore"
+)
+
+// CacheSubnetGroupObservation is the representation of the current state
+// that is observed for a CacheSubnetGroup.
+type CacheSubnetGroupObservation struct {
+	// VPCID is the VPC in which the cache subnet group was created.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// ARN is the Amazon Resource Name of the cache subnet group. It is
+	// used to address the resource in tagging API calls.
+	ARN string `json:"arn,omitempty"`
+
+	// Drift describes the most recently observed divergence between spec
+	// and the external CacheSubnetGroup, or nil if none was found.
+	Drift *CacheSubnetGroupDrift `json:"drift,omitempty"`
+}
+
+// CacheSubnetGroupDrift describes a detected divergence between the
+// desired and observed state of a CacheSubnetGroup.
+type CacheSubnetGroupDrift struct {
+	// AddedSubnetIDs are subnet IDs present in spec but missing from the
+	// external resource.
+	AddedSubnetIDs []string `json:"addedSubnetIds,omitempty"`
+
+	// RemovedSubnetIDs are subnet IDs present on the external resource but
+	// absent from spec.
+	RemovedSubnetIDs []string `json:"removedSubnetIds,omitempty"`
+
+	// DescriptionChanged is true when the external resource's description
+	// no longer matches spec.
+	DescriptionChanged bool `json:"descriptionChanged,omitempty"`
+
+	// AddedTags are tag keys that are missing, or out of date, on the
+	// external resource. This includes the automatic crossplane-* tags.
+	AddedTags []string `json:"addedTags,omitempty"`
+
+	// RemovedTags are tag keys present on the external resource but
+	// absent from spec.
+	RemovedTags []string `json:"removedTags,omitempty"`
+}
+
+// String returns a short, human-readable summary of the drift for use in
+// log messages and reconcile events. It is safe to call on a nil Drift.
+func (d *CacheSubnetGroupDrift) String() string {
+	if d == nil {
+		return ""
+	}
+
+	var parts []string
+	if d.DescriptionChanged {
+		parts = append(parts, "description differs")
+	}
+	if len(d.AddedSubnetIDs) > 0 {
+		parts = append(parts, fmt.Sprintf("missing subnets %v", d.AddedSubnetIDs))
+	}
+	if len(d.RemovedSubnetIDs) > 0 {
+		parts = append(parts, fmt.Sprintf("unexpected subnets %v", d.RemovedSubnetIDs))
+	}
+	if len(d.AddedTags) > 0 {
+		parts = append(parts, fmt.Sprintf("missing or out-of-date tags %v", d.AddedTags))
+	}
+	if len(d.RemovedTags) > 0 {
+		parts = append(parts, fmt.Sprintf("unexpected tags %v", d.RemovedTags))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// A CacheSubnetGroupSpec defines the desired state of a CacheSubnetGroup.
+type CacheSubnetGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CacheSubnetGroupParameters `json:"forProvider"`
+}
+
+// A CacheSubnetGroupStatus represents the observed state of a
+// CacheSubnetGroup.
+type CacheSubnetGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CacheSubnetGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CacheSubnetGroup is a managed resource that represents an AWS
+// ElastiCache Cache Subnet Group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+type CacheSubnetGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CacheSubnetGroupSpec   `json:"spec"`
+	Status CacheSubnetGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CacheSubnetGroupList contains a list of CacheSubnetGroups.
+type CacheSubnetGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CacheSubnetGroup `json:"items"`
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TypeDrifted indicates that a CacheSubnetGroup's observed state has
+// diverged from its spec under a driftDetectionPolicy of DetectOnly.
+const TypeDrifted xpv1.ConditionType = "Drifted"
+
+// ReasonDriftDetected is added to a Drifted condition when a diff was
+// found between spec and the observed AWS CacheSubnetGroup.
+const ReasonDriftDetected xpv1.ConditionReason = "DriftDetected"
+
+// ReasonNoDrift is added to a Drifted condition when the observed AWS
+// CacheSubnetGroup matches spec.
+const ReasonNoDrift xpv1.ConditionReason = "NoDrift"
+
+// Drifted returns a condition indicating that drift was detected between
+// the desired and observed state of a CacheSubnetGroup.
+func Drifted() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDrifted,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDriftDetected,
+	}
+}
+
+// NotDrifted returns a condition indicating that no drift was detected
+// between the desired and observed state of a CacheSubnetGroup. It clears
+// a previously-set Drifted condition once the resource converges again.
+func NotDrifted() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDrifted,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNoDrift,
+	}
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1alpha1 contains API Schema definitions for the cache v1alpha1
+// API group.
+// +kubebuilder:object:generate=true
+// +groupName=cache.aws.crossplane.io
+// +versionName=v1alpha1
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "cache.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects.
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// CacheSubnetGroupGroupKind is the group-qualified kind of a
+// CacheSubnetGroup, e.g. "CacheSubnetGroup.cache.aws.crossplane.io".
+var CacheSubnetGroupGroupKind = schema.GroupKind{Group: Group, Kind: "CacheSubnetGroup"}.String()
+
+// CacheSubnetGroupGroupVersionKind is the GroupVersionKind of a CacheSubnetGroup.
+var CacheSubnetGroupGroupVersionKind = SchemeGroupVersion.WithKind("CacheSubnetGroup")
+
+func init() {
+	SchemeBuilder.Register(&CacheSubnetGroup{}, &CacheSubnetGroupList{})
+}
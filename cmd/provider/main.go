@@ -0,0 +1,97 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command provider is the entrypoint for the ElastiCache Crossplane
+// provider's controller manager.
+package main
+
+import (
+	"context"
+
+	"gopkg.in/alecthomas/kingpin.v2"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/controller/cache/cachesubnetgroup"
+	"github.com/crossplane/provider-aws/pkg/debug"
+)
+
+func main() {
+	var (
+		app          = kingpin.New("provider-aws", "An ElastiCache Crossplane provider.").DefaultEnvars()
+		debugMode    = app.Flag("debug", "Run with debug logging.").Short('d').Bool()
+		syncInterval = app.Flag("sync", "Controller manager sync period, e.g. 5m, 1h.").Short('s').Default("1h").Duration()
+		leaderElect  = app.Flag("leader-election", "Use leader election for the controller manager.").Short('l').Default("false").Bool()
+
+		debugEndpoint = app.Flag("debug-endpoint", "Serve the admin diagnostics endpoint.").Default("false").Bool()
+		debugAddr     = app.Flag("debug-addr", "Address the admin diagnostics endpoint listens on.").Default(":8081").String()
+	)
+	kingpin.MustParse(app.Parse(kingpin.CommandLine.Args()))
+
+	log := logging.NewLogrLogger(ctrl.Log.WithName("provider-aws"))
+	if *debugMode {
+		log = logging.NewLogrLogger(ctrl.Log.WithName("provider-aws").V(1))
+	}
+
+	cfg, err := ctrl.GetConfig()
+	kingpin.FatalIfError(err, "cannot get API server rest config")
+
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
+		LeaderElection:   *leaderElect,
+		LeaderElectionID: "crossplane-leader-election-provider-aws",
+		SyncPeriod:       syncInterval,
+	})
+	kingpin.FatalIfError(err, "cannot create controller manager")
+
+	kingpin.FatalIfError(v1alpha1.SchemeBuilder.AddToScheme(mgr.GetScheme()), "cannot add cache APIs to scheme")
+
+	rl := workqueue.DefaultControllerRateLimiter()
+	kingpin.FatalIfError(cachesubnetgroup.Setup(mgr, log, rl), "cannot setup CacheSubnetGroup controller")
+
+	ctx := ctrl.SetupSignalHandler()
+
+	go func() {
+		err := debug.Serve(ctx, *debugEndpoint, *debugAddr, managedSummaries(mgr.GetClient()), debug.Default)
+		kingpin.FatalIfError(err, "admin diagnostics endpoint stopped unexpectedly")
+	}()
+
+	kingpin.FatalIfError(mgr.Start(ctx), "cannot start controller manager")
+}
+
+// managedSummaries lists every CacheSubnetGroup known to kube and
+// summarises it for the admin diagnostics endpoint.
+func managedSummaries(kube client.Client) debug.ManagedLister {
+	return func() []debug.ManagedSummary {
+		list := &v1alpha1.CacheSubnetGroupList{}
+		if err := kube.List(context.Background(), list); err != nil {
+			return nil
+		}
+
+		out := make([]debug.ManagedSummary, 0, len(list.Items))
+		for _, cr := range list.Items {
+			out = append(out, debug.ManagedSummary{
+				Kind:       v1alpha1.CacheSubnetGroupGroupKind,
+				Name:       cr.GetName(),
+				Conditions: cr.Status.Conditions,
+			})
+		}
+		return out
+	}
+}
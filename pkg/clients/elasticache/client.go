@@ -0,0 +1,94 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package elasticache provides utilities to manage AWS ElastiCache resources.
+package elasticache
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awscache "github.com/aws/aws-sdk-go-v2/service/elasticache"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+)
+
+// Client defines the ElastiCache client operations used by the cache
+// subnet group controller.
+type Client interface {
+	DescribeCacheSubnetGroupsRequest(input *awscache.DescribeCacheSubnetGroupsInput) awscache.DescribeCacheSubnetGroupsRequest
+	CreateCacheSubnetGroupRequest(input *awscache.CreateCacheSubnetGroupInput) awscache.CreateCacheSubnetGroupRequest
+	ModifyCacheSubnetGroupRequest(input *awscache.ModifyCacheSubnetGroupInput) awscache.ModifyCacheSubnetGroupRequest
+	DeleteCacheSubnetGroupRequest(input *awscache.DeleteCacheSubnetGroupInput) awscache.DeleteCacheSubnetGroupRequest
+	ListTagsForResourceRequest(input *awscache.ListTagsForResourceInput) awscache.ListTagsForResourceRequest
+	AddTagsToResourceRequest(input *awscache.AddTagsToResourceInput) awscache.AddTagsToResourceRequest
+	RemoveTagsFromResourceRequest(input *awscache.RemoveTagsFromResourceInput) awscache.RemoveTagsFromResourceRequest
+}
+
+// NewClient creates a new ElastiCache Client with the supplied AWS
+// configuration.
+func NewClient(cfg aws.Config) Client {
+	return awscache.New(cfg)
+}
+
+// DiffSubnetGroup compares the desired spec against the observed AWS
+// CacheSubnetGroup and returns the fields that differ, or nil if there is
+// no diff. A DriftDetectionPolicy of Ignore excludes Description from the
+// comparison.
+func DiffSubnetGroup(p v1alpha1.CacheSubnetGroupParameters, sg awscache.CacheSubnetGroup) *v1alpha1.CacheSubnetGroupDrift {
+	added, removed := diffSubnets(p.SubnetIDs, sg.Subnets)
+	descriptionChanged := p.DriftDetectionPolicy != v1alpha1.DriftDetectionIgnore &&
+		aws.StringValue(sg.CacheSubnetGroupDescription) != p.Description
+
+	if len(added) == 0 && len(removed) == 0 && !descriptionChanged {
+		return nil
+	}
+
+	return &v1alpha1.CacheSubnetGroupDrift{
+		AddedSubnetIDs:     added,
+		RemovedSubnetIDs:   removed,
+		DescriptionChanged: descriptionChanged,
+	}
+}
+
+// diffSubnets returns the subnet IDs present in ids but not in subnets
+// (added), and those present in subnets but not in ids (removed).
+func diffSubnets(ids []string, subnets []awscache.Subnet) (added, removed []string) {
+	observed := make(map[string]struct{}, len(subnets))
+	for _, s := range subnets {
+		observed[aws.StringValue(s.SubnetIdentifier)] = struct{}{}
+	}
+	desired := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		desired[id] = struct{}{}
+	}
+
+	for id := range desired {
+		if _, ok := observed[id]; !ok {
+			added = append(added, id)
+		}
+	}
+	for id := range observed {
+		if _, ok := desired[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+
+	sort.Strings(added)
+	sort.Strings(removed)
+
+	return added, removed
+}
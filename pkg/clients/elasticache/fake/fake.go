@@ -0,0 +1,94 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides a fake ElastiCache client for use in tests.
+package fake
+
+import (
+	"sync"
+
+	awscache "github.com/aws/aws-sdk-go-v2/service/elasticache"
+
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+)
+
+// this ensures that the mock implements the client interface
+var _ elasticache.Client = (*MockClient)(nil)
+
+// MockClient is a fake implementation of elasticache.Client. Every method
+// call is appended to Calls, in invocation order, so tests can assert on
+// call counts (e.g. that a middleware chain did not retry a permanent
+// failure) and on ordering.
+type MockClient struct {
+	mu    sync.Mutex
+	Calls []string
+
+	MockDescribeCacheSubnetGroupsRequest func(input *awscache.DescribeCacheSubnetGroupsInput) awscache.DescribeCacheSubnetGroupsRequest
+	MockCreateCacheSubnetGroupRequest    func(input *awscache.CreateCacheSubnetGroupInput) awscache.CreateCacheSubnetGroupRequest
+	MockModifyCacheSubnetGroupRequest    func(input *awscache.ModifyCacheSubnetGroupInput) awscache.ModifyCacheSubnetGroupRequest
+	MockDeleteCacheSubnetGroupRequest    func(input *awscache.DeleteCacheSubnetGroupInput) awscache.DeleteCacheSubnetGroupRequest
+	MockListTagsForResourceRequest       func(input *awscache.ListTagsForResourceInput) awscache.ListTagsForResourceRequest
+	MockAddTagsToResourceRequest         func(input *awscache.AddTagsToResourceInput) awscache.AddTagsToResourceRequest
+	MockRemoveTagsFromResourceRequest    func(input *awscache.RemoveTagsFromResourceInput) awscache.RemoveTagsFromResourceRequest
+}
+
+func (m *MockClient) recordCall(op string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Calls = append(m.Calls, op)
+}
+
+// DescribeCacheSubnetGroupsRequest calls the underlying MockDescribeCacheSubnetGroupsRequest method.
+func (m *MockClient) DescribeCacheSubnetGroupsRequest(input *awscache.DescribeCacheSubnetGroupsInput) awscache.DescribeCacheSubnetGroupsRequest {
+	m.recordCall("DescribeCacheSubnetGroups")
+	return m.MockDescribeCacheSubnetGroupsRequest(input)
+}
+
+// CreateCacheSubnetGroupRequest calls the underlying MockCreateCacheSubnetGroupRequest method.
+func (m *MockClient) CreateCacheSubnetGroupRequest(input *awscache.CreateCacheSubnetGroupInput) awscache.CreateCacheSubnetGroupRequest {
+	m.recordCall("CreateCacheSubnetGroup")
+	return m.MockCreateCacheSubnetGroupRequest(input)
+}
+
+// ModifyCacheSubnetGroupRequest calls the underlying MockModifyCacheSubnetGroupRequest method.
+func (m *MockClient) ModifyCacheSubnetGroupRequest(input *awscache.ModifyCacheSubnetGroupInput) awscache.ModifyCacheSubnetGroupRequest {
+	m.recordCall("ModifyCacheSubnetGroup")
+	return m.MockModifyCacheSubnetGroupRequest(input)
+}
+
+// DeleteCacheSubnetGroupRequest calls the underlying MockDeleteCacheSubnetGroupRequest method.
+func (m *MockClient) DeleteCacheSubnetGroupRequest(input *awscache.DeleteCacheSubnetGroupInput) awscache.DeleteCacheSubnetGroupRequest {
+	m.recordCall("DeleteCacheSubnetGroup")
+	return m.MockDeleteCacheSubnetGroupRequest(input)
+}
+
+// ListTagsForResourceRequest calls the underlying MockListTagsForResourceRequest method.
+func (m *MockClient) ListTagsForResourceRequest(input *awscache.ListTagsForResourceInput) awscache.ListTagsForResourceRequest {
+	m.recordCall("ListTagsForResource")
+	return m.MockListTagsForResourceRequest(input)
+}
+
+// AddTagsToResourceRequest calls the underlying MockAddTagsToResourceRequest method.
+func (m *MockClient) AddTagsToResourceRequest(input *awscache.AddTagsToResourceInput) awscache.AddTagsToResourceRequest {
+	m.recordCall("AddTagsToResource")
+	return m.MockAddTagsToResourceRequest(input)
+}
+
+// RemoveTagsFromResourceRequest calls the underlying MockRemoveTagsFromResourceRequest method.
+func (m *MockClient) RemoveTagsFromResourceRequest(input *awscache.RemoveTagsFromResourceInput) awscache.RemoveTagsFromResourceRequest {
+	m.recordCall("RemoveTagsFromResource")
+	return m.MockRemoveTagsFromResourceRequest(input)
+}
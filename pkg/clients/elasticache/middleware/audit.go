@@ -0,0 +1,42 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+)
+
+// Audit returns a Middleware that logs a structured record of every call
+// via l: its operation, duration and, on failure, the resulting error.
+func Audit(l logging.Logger) Middleware {
+	return func(next Invoker) Invoker {
+		return func(ctx context.Context, op string, call func() error) error {
+			started := time.Now()
+			err := next(ctx, op, call)
+			kvs := []interface{}{"operation", op, "duration", time.Since(started)}
+			if err != nil {
+				l.Info("AWS call failed", append(kvs, "error", err)...)
+				return err
+			}
+			l.Debug("AWS call", kvs...)
+			return nil
+		}
+	}
+}
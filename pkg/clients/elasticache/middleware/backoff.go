@@ -0,0 +1,65 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// throttlingErrors are the AWS error codes that Backoff treats as
+// transient and worth retrying.
+var throttlingErrors = []string{"Throttling", "RequestLimitExceeded"}
+
+// Backoff returns a Middleware that retries a call up to maxRetries times,
+// with exponential backoff and jitter between attempts, whenever it fails
+// with a throttling error. Any other error is returned immediately without
+// being retried, so a permanent failure (e.g. a validation error) invokes
+// the wrapped call exactly once.
+func Backoff(maxRetries int, base time.Duration) Middleware {
+	return func(next Invoker) Invoker {
+		return func(ctx context.Context, op string, call func() error) error {
+			var err error
+			for attempt := 0; ; attempt++ {
+				err = next(ctx, op, call)
+				if err == nil || !isThrottling(err) || attempt == maxRetries {
+					return err
+				}
+
+				wait := base * time.Duration(uint64(1)<<uint(attempt))
+				wait += time.Duration(rand.Int63n(int64(wait)/2 + 1)) // #nosec G404 -- jitter, not security sensitive
+
+				select {
+				case <-time.After(wait):
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		}
+	}
+}
+
+func isThrottling(err error) bool {
+	for _, code := range throttlingErrors {
+		if strings.Contains(err.Error(), code) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	callsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "provider_aws",
+		Subsystem: "elasticache",
+		Name:      "aws_calls_total",
+		Help:      "Total number of AWS ElastiCache API calls, by operation and outcome.",
+	}, []string{"operation", "outcome"})
+
+	callDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "provider_aws",
+		Subsystem: "elasticache",
+		Name:      "aws_call_duration_seconds",
+		Help:      "Latency of AWS ElastiCache API calls, by operation.",
+	}, []string{"operation"})
+)
+
+func init() {
+	prometheus.MustRegister(callsTotal, callDuration)
+}
+
+// Metrics returns a Middleware that records the count and latency of
+// every call via Prometheus, labelled by operation and, for count, by
+// outcome ("success" or "error").
+func Metrics() Middleware {
+	return func(next Invoker) Invoker {
+		return func(ctx context.Context, op string, call func() error) error {
+			started := time.Now()
+			err := next(ctx, op, call)
+
+			callDuration.WithLabelValues(op).Observe(time.Since(started).Seconds())
+			outcome := "success"
+			if err != nil {
+				outcome = "error"
+			}
+			callsTotal.WithLabelValues(op, outcome).Inc()
+
+			return err
+		}
+	}
+}
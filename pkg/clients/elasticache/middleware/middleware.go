@@ -0,0 +1,54 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package middleware provides a composable chain of request middlewares
+// that controllers can wrap around every AWS SDK call they make, for
+// cross-cutting concerns such as rate limiting, retries, metrics and
+// auditing. It has no dependency on any particular AWS service: a call is
+// identified only by its operation name, with the actual SDK invocation
+// supplied as a closure.
+package middleware
+
+import "context"
+
+// Invoker performs a single named AWS SDK call by running call. Op
+// identifies the call for logging, metrics and retry decisions (e.g.
+// "CreateCacheSubnetGroup").
+type Invoker func(ctx context.Context, op string, call func() error) error
+
+// A Middleware wraps an Invoker with additional behaviour, calling next to
+// continue the chain.
+type Middleware func(next Invoker) Invoker
+
+// Base returns the innermost Invoker of a chain: it simply runs call with
+// no additional behaviour.
+func Base() Invoker {
+	return func(_ context.Context, _ string, call func() error) error {
+		return call()
+	}
+}
+
+// Chain composes mws into a single Middleware. The first Middleware is
+// outermost, so it sees a call before and after every Middleware that
+// follows it in the list.
+func Chain(mws ...Middleware) Middleware {
+	return func(next Invoker) Invoker {
+		for i := len(mws) - 1; i >= 0; i-- {
+			next = mws[i](next)
+		}
+		return next
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+func TestChainOrdering(t *testing.T) {
+	var got []string
+
+	trace := func(name string) Middleware {
+		return func(next Invoker) Invoker {
+			return func(ctx context.Context, op string, call func() error) error {
+				got = append(got, name+":before")
+				err := next(ctx, op, call)
+				got = append(got, name+":after")
+				return err
+			}
+		}
+	}
+
+	chain := Chain(trace("outer"), trace("inner"))
+	invoke := chain(Base())
+
+	if err := invoke(context.Background(), "Op", func() error { got = append(got, "call"); return nil }); err != nil {
+		t.Fatalf("invoke(...): unexpected error: %v", err)
+	}
+
+	want := []string{"outer:before", "inner:before", "call", "inner:after", "outer:after"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("chain ordering: -want, +got:\n%s", diff)
+	}
+}
+
+func TestBackoffDoesNotRetryPermanentErrors(t *testing.T) {
+	errBoom := errors.New("boom")
+	calls := 0
+
+	invoke := Backoff(3, time.Millisecond)(Base())
+	err := invoke(context.Background(), "CreateCacheSubnetGroup", func() error {
+		calls++
+		return errBoom
+	})
+
+	if errors.Cause(err) != errBoom {
+		t.Errorf("invoke(...): got error %v, want %v", err, errBoom)
+	}
+	if calls != 1 {
+		t.Errorf("invoke(...): call count = %d, want 1 (permanent errors must not be retried)", calls)
+	}
+}
+
+func TestBackoffRetriesThrottling(t *testing.T) {
+	calls := 0
+
+	invoke := Backoff(3, time.Millisecond)(Base())
+	err := invoke(context.Background(), "CreateCacheSubnetGroup", func() error {
+		calls++
+		if calls < 3 {
+			return errors.New("Throttling: rate exceeded")
+		}
+		return nil
+	})
+
+	if err != nil {
+		t.Errorf("invoke(...): unexpected error: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("invoke(...): call count = %d, want 3", calls)
+	}
+}
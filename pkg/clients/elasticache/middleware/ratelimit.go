@@ -0,0 +1,67 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// regionKey is the context key under which the AWS region associated with
+// a call is stored, so RateLimit can apply a per-region token bucket
+// without every caller having to pass the region explicitly.
+type regionKey struct{}
+
+// WithRegion returns a copy of ctx carrying region, for consumption by
+// RateLimit.
+func WithRegion(ctx context.Context, region string) context.Context {
+	return context.WithValue(ctx, regionKey{}, region)
+}
+
+// RateLimit returns a Middleware that throttles calls to at most rps
+// requests per second, with bursts of up to burst, using an independent
+// token bucket per AWS region (as set by WithRegion). Calls for regions
+// that were never registered with WithRegion share a single bucket.
+func RateLimit(rps float64, burst int) Middleware {
+	var (
+		mu       sync.Mutex
+		limiters = map[string]*rate.Limiter{}
+	)
+
+	limiterFor := func(region string) *rate.Limiter {
+		mu.Lock()
+		defer mu.Unlock()
+		l, ok := limiters[region]
+		if !ok {
+			l = rate.NewLimiter(rate.Limit(rps), burst)
+			limiters[region] = l
+		}
+		return l
+	}
+
+	return func(next Invoker) Invoker {
+		return func(ctx context.Context, op string, call func() error) error {
+			region, _ := ctx.Value(regionKey{}).(string)
+			if err := limiterFor(region).Wait(ctx); err != nil {
+				return err
+			}
+			return next(ctx, op, call)
+		}
+	}
+}
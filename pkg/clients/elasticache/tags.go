@@ -0,0 +1,128 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticache
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awscache "github.com/aws/aws-sdk-go-v2/service/elasticache"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+)
+
+// Automatic tag keys this provider applies to every resource it manages,
+// so that externally-created resources can be told apart from
+// Crossplane-managed ones and traced back to the managed resource and
+// ProviderConfig that produced them.
+const (
+	TagKeyKind           = "crossplane-kind"
+	TagKeyName           = "crossplane-name"
+	TagKeyProviderConfig = "crossplane-providerconfig"
+)
+
+// DefaultTags returns the crossplane-standard automatic tags for cr: its
+// kind, its metadata name and, when cr references one, the ProviderConfig
+// it was reconciled with.
+//
+// TODO(providerconfig-defaulttags): these automatic tags are not
+// currently configurable via a providerConfig.spec.defaultTags field.
+// This snapshot of the repository has no ProviderConfig API type to hang
+// such a field off, so DefaultTags derives everything it needs from cr
+// itself. Once a ProviderConfig type is in scope, add DefaultTags there
+// and merge it in here ahead of cr's own tags.
+func DefaultTags(cr *v1alpha1.CacheSubnetGroup) []v1alpha1.Tag {
+	tags := []v1alpha1.Tag{
+		{Key: TagKeyKind, Value: v1alpha1.CacheSubnetGroupGroupKind},
+		{Key: TagKeyName, Value: cr.GetName()},
+	}
+	if ref := cr.GetProviderConfigReference(); ref != nil {
+		tags = append(tags, v1alpha1.Tag{Key: TagKeyProviderConfig, Value: ref.Name})
+	}
+	return tags
+}
+
+// WithDefaultTags returns tags with cr's automatic tags merged in. An
+// automatic tag always takes precedence over a user-supplied tag of the
+// same key, so that a misconfigured spec can never hide a resource from
+// the Crossplane instance managing it.
+func WithDefaultTags(cr *v1alpha1.CacheSubnetGroup, tags []v1alpha1.Tag) []v1alpha1.Tag {
+	merged := make(map[string]string, len(tags)+3)
+	order := make([]string, 0, len(tags)+3)
+
+	for _, t := range append(append([]v1alpha1.Tag{}, tags...), DefaultTags(cr)...) {
+		if _, ok := merged[t.Key]; !ok {
+			order = append(order, t.Key)
+		}
+		merged[t.Key] = t.Value
+	}
+
+	out := make([]v1alpha1.Tag, 0, len(order))
+	for _, k := range order {
+		out = append(out, v1alpha1.Tag{Key: k, Value: merged[k]})
+	}
+	return out
+}
+
+// TagsFromAWS converts AWS SDK tags to their v1alpha1 representation.
+func TagsFromAWS(tags []awscache.Tag) []v1alpha1.Tag {
+	out := make([]v1alpha1.Tag, 0, len(tags))
+	for _, t := range tags {
+		out = append(out, v1alpha1.Tag{Key: aws.StringValue(t.Key), Value: aws.StringValue(t.Value)})
+	}
+	return out
+}
+
+// TagsToAWS converts v1alpha1 tags to their AWS SDK representation.
+func TagsToAWS(tags []v1alpha1.Tag) []awscache.Tag {
+	out := make([]awscache.Tag, 0, len(tags))
+	for _, t := range tags {
+		out = append(out, awscache.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)})
+	}
+	return out
+}
+
+// DiffTags compares desired against observed and returns the tags that
+// need to be applied via AddTagsToResource (new keys, and existing keys
+// whose value changed) and the keys that need to be removed via
+// RemoveTagsFromResource (present in observed but absent from desired).
+func DiffTags(desired, observed []v1alpha1.Tag) (toUpsert []v1alpha1.Tag, toRemove []string) {
+	desiredByKey := make(map[string]string, len(desired))
+	for _, t := range desired {
+		desiredByKey[t.Key] = t.Value
+	}
+	observedByKey := make(map[string]string, len(observed))
+	for _, t := range observed {
+		observedByKey[t.Key] = t.Value
+	}
+
+	for k, v := range desiredByKey {
+		if ov, ok := observedByKey[k]; !ok || ov != v {
+			toUpsert = append(toUpsert, v1alpha1.Tag{Key: k, Value: v})
+		}
+	}
+	for k := range observedByKey {
+		if _, ok := desiredByKey[k]; !ok {
+			toRemove = append(toRemove, k)
+		}
+	}
+
+	sort.Slice(toUpsert, func(i, j int) bool { return toUpsert[i].Key < toUpsert[j].Key })
+	sort.Strings(toRemove)
+
+	return toUpsert, toRemove
+}
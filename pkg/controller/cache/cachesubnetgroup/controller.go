@@ -0,0 +1,405 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cachesubnetgroup manages the lifecycle of ElastiCache Cache
+// Subnet Group resources.
+package cachesubnetgroup
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awscache "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache/middleware"
+	"github.com/crossplane/provider-aws/pkg/debug"
+)
+
+const (
+	errNotCacheSubnetGroup = "managed resource is not a Cache Subnet Group"
+	errDescribeSubnetGroup = "cannot describe Cache Subnet Group"
+	errCreateSubnetGroup   = "cannot create Cache Subnet Group"
+	errModifySubnetGroup   = "cannot modify Cache Subnet Group"
+	errDeleteSubnetGroup   = "cannot delete Cache Subnet Group"
+	errListTags            = "cannot list tags for Cache Subnet Group"
+	errAddTags             = "cannot add tags to Cache Subnet Group"
+	errRemoveTags          = "cannot remove tags from Cache Subnet Group"
+)
+
+// controllerName is used to label diagnostic records pushed to the debug
+// recorder.
+const controllerName = "cachesubnetgroup"
+
+// reasonDrifted is the event reason emitted when a DriftDetectionPolicy of
+// DetectOnly finds a difference between spec and the observed AWS
+// CacheSubnetGroup.
+const reasonDrifted event.Reason = "DriftDetected"
+
+// Defaults for the AWS call middleware chain every connector builds. These
+// are deliberately conservative; ElastiCache's default account limits
+// leave plenty of headroom below them.
+const (
+	defaultRateLimitRPS   = 10
+	defaultRateLimitBurst = 20
+	defaultMaxRetries     = 4
+	defaultBackoffBase    = 200 * time.Millisecond
+)
+
+// Setup adds a controller that reconciles CacheSubnetGroup managed
+// resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.CacheSubnetGroupGroupKind)
+	log := l.WithValues("controller", name)
+	rec := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	chain := middleware.Chain(
+		middleware.Backoff(defaultMaxRetries, defaultBackoffBase),
+		middleware.RateLimit(defaultRateLimitRPS, defaultRateLimitBurst),
+		middleware.Metrics(),
+		middleware.Audit(log),
+		recordToDebugRecorder(debug.Default),
+	)
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CacheSubnetGroupGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:        mgr.GetClient(),
+			newClientFn: elasticache.NewClient,
+			log:         log,
+			recorder:    rec,
+			invoke:      chain(middleware.Base()),
+		}),
+		managed.WithLogger(log),
+		managed.WithRecorder(rec))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl)}).
+		For(&v1alpha1.CacheSubnetGroup{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) elasticache.Client
+	log         logging.Logger
+	recorder    event.Recorder
+
+	// invoke is the AWS-call middleware chain built once at Setup time and
+	// shared by every external client this connector produces, so that
+	// stateful middleware (e.g. RateLimit's per-region token buckets)
+	// persists across reconciles instead of being rebuilt on every Connect.
+	invoke middleware.Invoker
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.CacheSubnetGroup)
+	if !ok {
+		return nil, errors.New(errNotCacheSubnetGroup)
+	}
+
+	cfg, err := awsclients.GetConfig(ctx, c.kube, cr, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{
+		client:   c.newClientFn(*cfg),
+		invoke:   c.invoke,
+		recorder: c.recorder,
+	}, nil
+}
+
+// recordToDebugRecorder adapts a debug.Recorder into a middleware.Middleware
+// so every call that completes the chain is also pushed to the admin
+// /debug/aws-calls endpoint.
+func recordToDebugRecorder(rec debug.Recorder) middleware.Middleware {
+	return func(next middleware.Invoker) middleware.Invoker {
+		return func(ctx context.Context, op string, call func() error) error {
+			started := time.Now()
+			err := next(ctx, op, call)
+
+			r := debug.AWSCallRecord{
+				Controller: controllerName,
+				Operation:  op,
+				StartedAt:  started,
+				Duration:   time.Since(started),
+			}
+			if err != nil {
+				r.Error = err.Error()
+			}
+			rec.RecordAWSCall(r)
+
+			return err
+		}
+	}
+}
+
+type external struct {
+	client elasticache.Client
+
+	// invoke wraps every AWS call in this external client's middleware
+	// chain (rate limiting, retries, metrics, auditing). It is nil in unit
+	// tests that construct external directly, so invoker() falls back to
+	// middleware.Base(), which runs the call with no extra behaviour.
+	invoke middleware.Invoker
+
+	// recorder emits Kubernetes events for this external client's managed
+	// resource. It is nil in unit tests that construct external directly,
+	// so callers must guard every use with e.record.
+	recorder event.Recorder
+}
+
+// record emits e on cr if this external client has a recorder configured.
+// It is a no-op in unit tests that construct external directly without one.
+func (e *external) record(cr resource.Managed, ev event.Event) {
+	if e.recorder != nil {
+		e.recorder.Event(cr, ev)
+	}
+}
+
+func (e *external) invoker() middleware.Invoker {
+	if e.invoke != nil {
+		return e.invoke
+	}
+	return middleware.Base()
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
+	cr, ok := mg.(*v1alpha1.CacheSubnetGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCacheSubnetGroup)
+	}
+
+	ctx = middleware.WithRegion(ctx, cr.Spec.ForProvider.Region)
+
+	var rsp *awscache.DescribeCacheSubnetGroupsResponse
+	err := e.invoker()(ctx, "DescribeCacheSubnetGroups", func() error {
+		var sendErr error
+		rsp, sendErr = e.client.DescribeCacheSubnetGroupsRequest(&awscache.DescribeCacheSubnetGroupsInput{
+			CacheSubnetGroupName: aws.String(meta.GetExternalName(cr)),
+		}).Send(ctx)
+		return sendErr
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribeSubnetGroup)
+	}
+
+	if len(rsp.CacheSubnetGroups) == 0 {
+		return managed.ExternalObservation{}, nil
+	}
+
+	sg := rsp.CacheSubnetGroups[0]
+	cr.SetConditions(xpv1.Available())
+	cr.Status.AtProvider.ARN = aws.StringValue(sg.ARN)
+
+	diff := elasticache.DiffSubnetGroup(cr.Spec.ForProvider, sg)
+
+	var tagRsp *awscache.ListTagsForResourceResponse
+	err = e.invoker()(ctx, "ListTagsForResource", func() error {
+		var sendErr error
+		tagRsp, sendErr = e.client.ListTagsForResourceRequest(&awscache.ListTagsForResourceInput{
+			ResourceName: sg.ARN,
+		}).Send(ctx)
+		return sendErr
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListTags)
+	}
+
+	toUpsert, toRemove := elasticache.DiffTags(
+		elasticache.WithDefaultTags(cr, cr.Spec.ForProvider.Tags),
+		elasticache.TagsFromAWS(tagRsp.TagList),
+	)
+	if len(toUpsert) > 0 || len(toRemove) > 0 {
+		if diff == nil {
+			diff = &v1alpha1.CacheSubnetGroupDrift{}
+		}
+		for _, t := range toUpsert {
+			diff.AddedTags = append(diff.AddedTags, t.Key)
+		}
+		diff.RemovedTags = toRemove
+	}
+
+	cr.Status.AtProvider.Drift = diff
+
+	if cr.Spec.ForProvider.DriftDetectionPolicy == v1alpha1.DriftDetectionDetectOnly {
+		if diff != nil {
+			cr.SetConditions(v1alpha1.Drifted())
+			e.record(cr, event.Normal(reasonDrifted, diff.String()))
+		} else {
+			cr.SetConditions(v1alpha1.NotDrifted())
+		}
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	o := managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: diff == nil,
+	}
+	if diff != nil {
+		o.Diff = diff.String()
+	}
+	return o, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CacheSubnetGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCacheSubnetGroup)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	ctx = middleware.WithRegion(ctx, cr.Spec.ForProvider.Region)
+	var rsp *awscache.CreateCacheSubnetGroupResponse
+	err := e.invoker()(ctx, "CreateCacheSubnetGroup", func() error {
+		var sendErr error
+		rsp, sendErr = e.client.CreateCacheSubnetGroupRequest(&awscache.CreateCacheSubnetGroupInput{
+			CacheSubnetGroupName:        aws.String(meta.GetExternalName(cr)),
+			CacheSubnetGroupDescription: aws.String(cr.Spec.ForProvider.Description),
+			SubnetIds:                   cr.Spec.ForProvider.SubnetIDs,
+		}).Send(ctx)
+		return sendErr
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateSubnetGroup)
+	}
+
+	tags := elasticache.TagsToAWS(elasticache.WithDefaultTags(cr, cr.Spec.ForProvider.Tags))
+	err = e.invoker()(ctx, "AddTagsToResource", func() error {
+		_, sendErr := e.client.AddTagsToResourceRequest(&awscache.AddTagsToResourceInput{
+			ResourceName: rsp.CacheSubnetGroup.ARN,
+			Tags:         tags,
+		}).Send(ctx)
+		return sendErr
+	})
+
+	return managed.ExternalCreation{}, errors.Wrap(err, errAddTags)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CacheSubnetGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCacheSubnetGroup)
+	}
+
+	ctx = middleware.WithRegion(ctx, cr.Spec.ForProvider.Region)
+	err := e.invoker()(ctx, "ModifyCacheSubnetGroup", func() error {
+		_, sendErr := e.client.ModifyCacheSubnetGroupRequest(&awscache.ModifyCacheSubnetGroupInput{
+			CacheSubnetGroupName:        aws.String(meta.GetExternalName(cr)),
+			CacheSubnetGroupDescription: aws.String(cr.Spec.ForProvider.Description),
+			SubnetIds:                   cr.Spec.ForProvider.SubnetIDs,
+		}).Send(ctx)
+		return sendErr
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errModifySubnetGroup)
+	}
+
+	return managed.ExternalUpdate{}, e.syncTags(ctx, cr)
+}
+
+// syncTags brings the external resource's tags in line with cr's desired
+// tags (including the automatic crossplane-* tags), using the ARN last
+// observed for cr. It is a no-op if no ARN has been observed yet.
+func (e *external) syncTags(ctx context.Context, cr *v1alpha1.CacheSubnetGroup) error {
+	arn := cr.Status.AtProvider.ARN
+	if arn == "" {
+		return nil
+	}
+
+	var tagRsp *awscache.ListTagsForResourceResponse
+	err := e.invoker()(ctx, "ListTagsForResource", func() error {
+		var sendErr error
+		tagRsp, sendErr = e.client.ListTagsForResourceRequest(&awscache.ListTagsForResourceInput{
+			ResourceName: aws.String(arn),
+		}).Send(ctx)
+		return sendErr
+	})
+	if err != nil {
+		return errors.Wrap(err, errListTags)
+	}
+
+	toUpsert, toRemove := elasticache.DiffTags(
+		elasticache.WithDefaultTags(cr, cr.Spec.ForProvider.Tags),
+		elasticache.TagsFromAWS(tagRsp.TagList),
+	)
+
+	if len(toRemove) > 0 {
+		err := e.invoker()(ctx, "RemoveTagsFromResource", func() error {
+			_, sendErr := e.client.RemoveTagsFromResourceRequest(&awscache.RemoveTagsFromResourceInput{
+				ResourceName: aws.String(arn),
+				TagKeys:      toRemove,
+			}).Send(ctx)
+			return sendErr
+		})
+		if err != nil {
+			return errors.Wrap(err, errRemoveTags)
+		}
+	}
+
+	if len(toUpsert) > 0 {
+		err := e.invoker()(ctx, "AddTagsToResource", func() error {
+			_, sendErr := e.client.AddTagsToResourceRequest(&awscache.AddTagsToResourceInput{
+				ResourceName: aws.String(arn),
+				Tags:         elasticache.TagsToAWS(toUpsert),
+			}).Send(ctx)
+			return sendErr
+		})
+		if err != nil {
+			return errors.Wrap(err, errAddTags)
+		}
+	}
+
+	return nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CacheSubnetGroup)
+	if !ok {
+		return errors.New(errNotCacheSubnetGroup)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	ctx = middleware.WithRegion(ctx, cr.Spec.ForProvider.Region)
+	err := e.invoker()(ctx, "DeleteCacheSubnetGroup", func() error {
+		_, sendErr := e.client.DeleteCacheSubnetGroupRequest(&awscache.DeleteCacheSubnetGroupInput{
+			CacheSubnetGroupName: aws.String(meta.GetExternalName(cr)),
+		}).Send(ctx)
+		return sendErr
+	})
+
+	return errors.Wrap(err, errDeleteSubnetGroup)
+}
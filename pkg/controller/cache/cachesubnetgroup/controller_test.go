@@ -5,7 +5,7 @@ Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
 You may obtain a copy of the License at
 
-   http://www.apache.org/licenses/LICENSE-2.0
+	http://www.apache.org/licenses/LICENSE-2.0
 
 Unless required by applicable law or agreed to in writing, software
 distributed under the License is distributed on an "AS IS" BASIS,
@@ -19,24 +19,29 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awscache "github.com/aws/aws-sdk-go-v2/service/elasticache"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
 	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
 	"github.com/crossplane/provider-aws/pkg/clients/elasticache/fake"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache/middleware"
 )
 
 var (
 	sgDescription = "some description"
 	subnetID      = "some ID"
+	sgARN         = "arn:aws:elasticache:us-east-1:123456789012:subnetgroup:some-group"
 
 	// replaceMe = "replace-me!"
 	errBoom = errors.New("boom")
@@ -57,6 +62,10 @@ func withSpec(p v1alpha1.CacheSubnetGroupParameters) csgModifier {
 	return func(r *v1alpha1.CacheSubnetGroup) { r.Spec.ForProvider = p }
 }
 
+func withObservation(o v1alpha1.CacheSubnetGroupObservation) csgModifier {
+	return func(r *v1alpha1.CacheSubnetGroup) { r.Status.AtProvider = o }
+}
+
 func csg(m ...csgModifier) *v1alpha1.CacheSubnetGroup {
 	cr := &v1alpha1.CacheSubnetGroup{}
 	for _, f := range m {
@@ -65,6 +74,19 @@ func csg(m ...csgModifier) *v1alpha1.CacheSubnetGroup {
 	return cr
 }
 
+// noTagDrift returns a MockListTagsForResourceRequest that reports cr's
+// spec tags and automatic tags already present on the external resource, so tests that
+// aren't exercising tag reconciliation don't pick up spurious drift.
+func noTagDrift(cr *v1alpha1.CacheSubnetGroup) func(*awscache.ListTagsForResourceInput) awscache.ListTagsForResourceRequest {
+	return func(input *awscache.ListTagsForResourceInput) awscache.ListTagsForResourceRequest {
+		return awscache.ListTagsForResourceRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.ListTagsForResourceOutput{
+				TagList: elasticache.TagsToAWS(elasticache.WithDefaultTags(cr, cr.Spec.ForProvider.Tags)),
+			}},
+		}
+	}
+}
+
 var _ managed.ExternalClient = &external{}
 var _ managed.ExternalConnecter = &connector{}
 
@@ -89,6 +111,7 @@ func TestObserve(t *testing.T) {
 							}},
 						}
 					},
+					MockListTagsForResourceRequest: noTagDrift(csg()),
 				},
 				cr: csg(),
 			},
@@ -117,6 +140,10 @@ func TestObserve(t *testing.T) {
 							}},
 						}
 					},
+					MockListTagsForResourceRequest: noTagDrift(csg(withSpec(v1alpha1.CacheSubnetGroupParameters{
+						Description: sgDescription,
+						SubnetIDs:   []string{subnetID},
+					}))),
 				},
 				cr: csg(withSpec(v1alpha1.CacheSubnetGroupParameters{
 					Description: sgDescription,
@@ -150,6 +177,222 @@ func TestObserve(t *testing.T) {
 				err: errors.Wrap(errBoom, errDescribeSubnetGroup),
 			},
 		},
+		"DriftEnforceDetected": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheSubnetGroupsRequest: func(input *awscache.DescribeCacheSubnetGroupsInput) awscache.DescribeCacheSubnetGroupsRequest {
+						return awscache.DescribeCacheSubnetGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.DescribeCacheSubnetGroupsOutput{
+								CacheSubnetGroups: []awscache.CacheSubnetGroup{{
+									CacheSubnetGroupDescription: aws.String("a different description"),
+									Subnets: []awscache.Subnet{
+										{SubnetIdentifier: aws.String(subnetID)},
+									},
+								}},
+							}},
+						}
+					},
+					MockListTagsForResourceRequest: noTagDrift(csg(withSpec(v1alpha1.CacheSubnetGroupParameters{
+						Description: sgDescription,
+						SubnetIDs:   []string{subnetID},
+					}))),
+				},
+				cr: csg(withSpec(v1alpha1.CacheSubnetGroupParameters{
+					Description: sgDescription,
+					SubnetIDs:   []string{subnetID},
+				})),
+			},
+			want: want{
+				cr: csg(withSpec(v1alpha1.CacheSubnetGroupParameters{
+					Description: sgDescription,
+					SubnetIDs:   []string{subnetID},
+				}), withConditions(xpv1.Available()), withObservation(v1alpha1.CacheSubnetGroupObservation{
+					Drift: &v1alpha1.CacheSubnetGroupDrift{DescriptionChanged: true},
+				})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					Diff:             "description differs",
+				},
+			},
+		},
+		"DriftDetectOnlyNeverModifies": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheSubnetGroupsRequest: func(input *awscache.DescribeCacheSubnetGroupsInput) awscache.DescribeCacheSubnetGroupsRequest {
+						return awscache.DescribeCacheSubnetGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.DescribeCacheSubnetGroupsOutput{
+								CacheSubnetGroups: []awscache.CacheSubnetGroup{{
+									CacheSubnetGroupDescription: aws.String("a different description"),
+								}},
+							}},
+						}
+					},
+					MockListTagsForResourceRequest: noTagDrift(csg(withSpec(v1alpha1.CacheSubnetGroupParameters{
+						Description:          sgDescription,
+						DriftDetectionPolicy: v1alpha1.DriftDetectionDetectOnly,
+					}))),
+				},
+				cr: csg(withSpec(v1alpha1.CacheSubnetGroupParameters{
+					Description:          sgDescription,
+					DriftDetectionPolicy: v1alpha1.DriftDetectionDetectOnly,
+				})),
+			},
+			want: want{
+				cr: csg(withSpec(v1alpha1.CacheSubnetGroupParameters{
+					Description:          sgDescription,
+					DriftDetectionPolicy: v1alpha1.DriftDetectionDetectOnly,
+				}), withConditions(xpv1.Available(), v1alpha1.Drifted()), withObservation(v1alpha1.CacheSubnetGroupObservation{
+					Drift: &v1alpha1.CacheSubnetGroupDrift{DescriptionChanged: true},
+				})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DriftDetectOnlyClearsPreviousDrift": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheSubnetGroupsRequest: func(input *awscache.DescribeCacheSubnetGroupsInput) awscache.DescribeCacheSubnetGroupsRequest {
+						return awscache.DescribeCacheSubnetGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.DescribeCacheSubnetGroupsOutput{
+								CacheSubnetGroups: []awscache.CacheSubnetGroup{{
+									CacheSubnetGroupDescription: aws.String(sgDescription),
+									Subnets: []awscache.Subnet{
+										{SubnetIdentifier: aws.String(subnetID)},
+									},
+								}},
+							}},
+						}
+					},
+					MockListTagsForResourceRequest: noTagDrift(csg(withSpec(v1alpha1.CacheSubnetGroupParameters{
+						Description:          sgDescription,
+						SubnetIDs:            []string{subnetID},
+						DriftDetectionPolicy: v1alpha1.DriftDetectionDetectOnly,
+					}))),
+				},
+				cr: csg(withSpec(v1alpha1.CacheSubnetGroupParameters{
+					Description:          sgDescription,
+					SubnetIDs:            []string{subnetID},
+					DriftDetectionPolicy: v1alpha1.DriftDetectionDetectOnly,
+				}), withConditions(v1alpha1.Drifted())),
+			},
+			want: want{
+				cr: csg(withSpec(v1alpha1.CacheSubnetGroupParameters{
+					Description:          sgDescription,
+					SubnetIDs:            []string{subnetID},
+					DriftDetectionPolicy: v1alpha1.DriftDetectionDetectOnly,
+				}), withConditions(xpv1.Available(), v1alpha1.NotDrifted())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DriftIgnoreDescription": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheSubnetGroupsRequest: func(input *awscache.DescribeCacheSubnetGroupsInput) awscache.DescribeCacheSubnetGroupsRequest {
+						return awscache.DescribeCacheSubnetGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.DescribeCacheSubnetGroupsOutput{
+								CacheSubnetGroups: []awscache.CacheSubnetGroup{{
+									CacheSubnetGroupDescription: aws.String("a different description"),
+									Subnets: []awscache.Subnet{
+										{SubnetIdentifier: aws.String(subnetID)},
+									},
+								}},
+							}},
+						}
+					},
+					MockListTagsForResourceRequest: noTagDrift(csg(withSpec(v1alpha1.CacheSubnetGroupParameters{
+						Description:          sgDescription,
+						SubnetIDs:            []string{subnetID},
+						DriftDetectionPolicy: v1alpha1.DriftDetectionIgnore,
+					}))),
+				},
+				cr: csg(withSpec(v1alpha1.CacheSubnetGroupParameters{
+					Description:          sgDescription,
+					SubnetIDs:            []string{subnetID},
+					DriftDetectionPolicy: v1alpha1.DriftDetectionIgnore,
+				})),
+			},
+			want: want{
+				cr: csg(withSpec(v1alpha1.CacheSubnetGroupParameters{
+					Description:          sgDescription,
+					SubnetIDs:            []string{subnetID},
+					DriftDetectionPolicy: v1alpha1.DriftDetectionIgnore,
+				}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TagsOutOfDate": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheSubnetGroupsRequest: func(input *awscache.DescribeCacheSubnetGroupsInput) awscache.DescribeCacheSubnetGroupsRequest {
+						return awscache.DescribeCacheSubnetGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.DescribeCacheSubnetGroupsOutput{
+								CacheSubnetGroups: []awscache.CacheSubnetGroup{{ARN: aws.String(sgARN)}},
+							}},
+						}
+					},
+					MockListTagsForResourceRequest: func(input *awscache.ListTagsForResourceInput) awscache.ListTagsForResourceRequest {
+						return awscache.ListTagsForResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.ListTagsForResourceOutput{
+								TagList: []awscache.Tag{
+									{Key: aws.String("stale"), Value: aws.String("tag")},
+								},
+							}},
+						}
+					},
+				},
+				cr: csg(withSpec(v1alpha1.CacheSubnetGroupParameters{
+					Tags: []v1alpha1.Tag{{Key: "team", Value: "cache"}},
+				})),
+			},
+			want: want{
+				cr: csg(withSpec(v1alpha1.CacheSubnetGroupParameters{
+					Tags: []v1alpha1.Tag{{Key: "team", Value: "cache"}},
+				}), withConditions(xpv1.Available()), withObservation(v1alpha1.CacheSubnetGroupObservation{
+					ARN: sgARN,
+					Drift: &v1alpha1.CacheSubnetGroupDrift{
+						AddedTags:   []string{"crossplane-kind", "crossplane-name", "team"},
+						RemovedTags: []string{"stale"},
+					},
+				})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					Diff:             "missing or out-of-date tags [crossplane-kind crossplane-name team]; unexpected tags [stale]",
+				},
+			},
+		},
+		"ListTagsFail": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheSubnetGroupsRequest: func(input *awscache.DescribeCacheSubnetGroupsInput) awscache.DescribeCacheSubnetGroupsRequest {
+						return awscache.DescribeCacheSubnetGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.DescribeCacheSubnetGroupsOutput{
+								CacheSubnetGroups: []awscache.CacheSubnetGroup{{}},
+							}},
+						}
+					},
+					MockListTagsForResourceRequest: func(input *awscache.ListTagsForResourceInput) awscache.ListTagsForResourceRequest {
+						return awscache.ListTagsForResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: csg(),
+			},
+			want: want{
+				cr:  csg(withConditions(xpv1.Available())),
+				err: errors.Wrap(errBoom, errListTags),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -186,7 +429,14 @@ func TestCreate(t *testing.T) {
 				cache: &fake.MockClient{
 					MockCreateCacheSubnetGroupRequest: func(input *awscache.CreateCacheSubnetGroupInput) awscache.CreateCacheSubnetGroupRequest {
 						return awscache.CreateCacheSubnetGroupRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.CreateCacheSubnetGroupOutput{}},
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.CreateCacheSubnetGroupOutput{
+								CacheSubnetGroup: &awscache.CacheSubnetGroup{ARN: aws.String(sgARN)},
+							}},
+						}
+					},
+					MockAddTagsToResourceRequest: func(input *awscache.AddTagsToResourceInput) awscache.AddTagsToResourceRequest {
+						return awscache.AddTagsToResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.AddTagsToResourceOutput{}},
 						}
 					},
 				},
@@ -224,6 +474,35 @@ func TestCreate(t *testing.T) {
 				err: errors.Wrap(errBoom, errCreateSubnetGroup),
 			},
 		},
+		"TagFail": {
+			args: args{
+				cache: &fake.MockClient{
+					MockCreateCacheSubnetGroupRequest: func(input *awscache.CreateCacheSubnetGroupInput) awscache.CreateCacheSubnetGroupRequest {
+						return awscache.CreateCacheSubnetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.CreateCacheSubnetGroupOutput{
+								CacheSubnetGroup: &awscache.CacheSubnetGroup{ARN: aws.String(sgARN)},
+							}},
+						}
+					},
+					MockAddTagsToResourceRequest: func(input *awscache.AddTagsToResourceInput) awscache.AddTagsToResourceRequest {
+						return awscache.AddTagsToResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: csg(withSpec(v1alpha1.CacheSubnetGroupParameters{
+					SubnetIDs:   []string{subnetID},
+					Description: sgDescription,
+				})),
+			},
+			want: want{
+				cr: csg((withSpec(v1alpha1.CacheSubnetGroupParameters{
+					SubnetIDs:   []string{subnetID},
+					Description: sgDescription,
+				})), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errAddTags),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -244,6 +523,72 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+func TestCreateMiddlewareDoesNotRetryPermanentErrors(t *testing.T) {
+	mock := &fake.MockClient{
+		MockCreateCacheSubnetGroupRequest: func(input *awscache.CreateCacheSubnetGroupInput) awscache.CreateCacheSubnetGroupRequest {
+			return awscache.CreateCacheSubnetGroupRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+			}
+		},
+	}
+
+	chain := middleware.Chain(middleware.Backoff(3, time.Millisecond))
+	e := &external{client: mock, invoke: chain(middleware.Base())}
+
+	cr := csg(withSpec(v1alpha1.CacheSubnetGroupParameters{
+		SubnetIDs:   []string{subnetID},
+		Description: sgDescription,
+	}))
+
+	if _, err := e.Create(context.Background(), cr); errors.Cause(err) != errBoom {
+		t.Fatalf("Create(...): got error %v, want %v", err, errBoom)
+	}
+	if len(mock.Calls) != 1 {
+		t.Errorf("Create(...): client was invoked %d times, want 1 (a permanent error must not be retried)", len(mock.Calls))
+	}
+}
+
+// fakeRecorder is a minimal event.Recorder that captures the last event it
+// was given, for tests that assert on drift notifications.
+type fakeRecorder struct {
+	got event.Event
+}
+
+func (f *fakeRecorder) Event(_ runtime.Object, e event.Event) { f.got = e }
+
+func TestObserveDetectOnlyEmitsDriftEvent(t *testing.T) {
+	mock := &fake.MockClient{
+		MockDescribeCacheSubnetGroupsRequest: func(input *awscache.DescribeCacheSubnetGroupsInput) awscache.DescribeCacheSubnetGroupsRequest {
+			return awscache.DescribeCacheSubnetGroupsRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.DescribeCacheSubnetGroupsOutput{
+					CacheSubnetGroups: []awscache.CacheSubnetGroup{{
+						CacheSubnetGroupDescription: aws.String("a different description"),
+					}},
+				}},
+			}
+		},
+	}
+	cr := csg(withSpec(v1alpha1.CacheSubnetGroupParameters{
+		Description:          sgDescription,
+		DriftDetectionPolicy: v1alpha1.DriftDetectionDetectOnly,
+	}))
+	mock.MockListTagsForResourceRequest = noTagDrift(cr)
+
+	rec := &fakeRecorder{}
+	e := &external{client: mock, recorder: rec}
+
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe(...): unexpected error: %v", err)
+	}
+
+	if rec.got.Reason != reasonDrifted {
+		t.Errorf("Observe(...): event reason = %q, want %q", rec.got.Reason, reasonDrifted)
+	}
+	if rec.got.Message == "" {
+		t.Error("Observe(...): event message is empty, want a description of the diff")
+	}
+}
+
 func TestUpdate(t *testing.T) {
 	type want struct {
 		cr     *v1alpha1.CacheSubnetGroup
@@ -298,6 +643,78 @@ func TestUpdate(t *testing.T) {
 				err: errors.Wrap(errBoom, errModifySubnetGroup),
 			},
 		},
+		"TagsOutOfDate": {
+			args: args{
+				cache: &fake.MockClient{
+					MockModifyCacheSubnetGroupRequest: func(input *awscache.ModifyCacheSubnetGroupInput) awscache.ModifyCacheSubnetGroupRequest {
+						return awscache.ModifyCacheSubnetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.ModifyCacheSubnetGroupOutput{}},
+						}
+					},
+					MockListTagsForResourceRequest: func(input *awscache.ListTagsForResourceInput) awscache.ListTagsForResourceRequest {
+						return awscache.ListTagsForResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.ListTagsForResourceOutput{
+								TagList: []awscache.Tag{
+									{Key: aws.String("stale"), Value: aws.String("tag")},
+								},
+							}},
+						}
+					},
+					MockAddTagsToResourceRequest: func(input *awscache.AddTagsToResourceInput) awscache.AddTagsToResourceRequest {
+						return awscache.AddTagsToResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.AddTagsToResourceOutput{}},
+						}
+					},
+					MockRemoveTagsFromResourceRequest: func(input *awscache.RemoveTagsFromResourceInput) awscache.RemoveTagsFromResourceRequest {
+						return awscache.RemoveTagsFromResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.RemoveTagsFromResourceOutput{}},
+						}
+					},
+				},
+				cr: csg(withSpec(v1alpha1.CacheSubnetGroupParameters{
+					SubnetIDs:   []string{subnetID},
+					Description: sgDescription,
+				}), withObservation(v1alpha1.CacheSubnetGroupObservation{ARN: sgARN})),
+			},
+			want: want{
+				cr: csg(withSpec(v1alpha1.CacheSubnetGroupParameters{
+					SubnetIDs:   []string{subnetID},
+					Description: sgDescription,
+				}), withObservation(v1alpha1.CacheSubnetGroupObservation{ARN: sgARN})),
+			},
+		},
+		"TagUpdateFail": {
+			args: args{
+				cache: &fake.MockClient{
+					MockModifyCacheSubnetGroupRequest: func(input *awscache.ModifyCacheSubnetGroupInput) awscache.ModifyCacheSubnetGroupRequest {
+						return awscache.ModifyCacheSubnetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.ModifyCacheSubnetGroupOutput{}},
+						}
+					},
+					MockListTagsForResourceRequest: func(input *awscache.ListTagsForResourceInput) awscache.ListTagsForResourceRequest {
+						return awscache.ListTagsForResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.ListTagsForResourceOutput{}},
+						}
+					},
+					MockAddTagsToResourceRequest: func(input *awscache.AddTagsToResourceInput) awscache.AddTagsToResourceRequest {
+						return awscache.AddTagsToResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: csg(withSpec(v1alpha1.CacheSubnetGroupParameters{
+					SubnetIDs:   []string{subnetID},
+					Description: sgDescription,
+				}), withObservation(v1alpha1.CacheSubnetGroupObservation{ARN: sgARN})),
+			},
+			want: want{
+				cr: csg(withSpec(v1alpha1.CacheSubnetGroupParameters{
+					SubnetIDs:   []string{subnetID},
+					Description: sgDescription,
+				}), withObservation(v1alpha1.CacheSubnetGroupObservation{ARN: sgARN})),
+				err: errors.Wrap(errBoom, errAddTags),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -0,0 +1,117 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package debug implements an optional, in-process diagnostics subsystem
+// for provider-aws. A Recorder holds structured records of AWS calls and
+// of reconciles, which an admin HTTP server (see Serve) can dump on
+// request for troubleshooting managed resources. Controllers push AWS call
+// records into it; no controller in this provider calls RecordReconcile,
+// so the reconcile buffer stays empty unless a caller fills it.
+package debug
+
+import (
+	"sync"
+	"time"
+)
+
+// ringSize bounds the number of entries retained per buffer so a
+// long-running provider cannot grow the recorder without bound.
+const ringSize = 256
+
+// AWSCallRecord describes a single AWS SDK request made by a controller.
+type AWSCallRecord struct {
+	Controller string        `json:"controller"`
+	Operation  string        `json:"operation"`
+	StartedAt  time.Time     `json:"startedAt"`
+	Duration   time.Duration `json:"duration"`
+	Error      string        `json:"error,omitempty"`
+}
+
+// ReconcileRecord describes a single managed resource reconcile.
+type ReconcileRecord struct {
+	Controller string        `json:"controller"`
+	Name       string        `json:"name"`
+	StartedAt  time.Time     `json:"startedAt"`
+	Duration   time.Duration `json:"duration"`
+	Error      string        `json:"error,omitempty"`
+}
+
+// A Recorder receives structured diagnostic events emitted by controllers.
+// Implementations must be safe for concurrent use.
+type Recorder interface {
+	RecordAWSCall(r AWSCallRecord)
+	RecordReconcile(r ReconcileRecord)
+	AWSCalls() []AWSCallRecord
+	Reconciles() []ReconcileRecord
+}
+
+// ring is a fixed-capacity, append-only Recorder backed by in-memory
+// slices. The zero value is ready to use; NewRecorder returns one.
+type ring struct {
+	mu         sync.Mutex
+	awsCalls   []AWSCallRecord
+	reconciles []ReconcileRecord
+}
+
+// NewRecorder returns a Recorder backed by bounded in-memory ring buffers.
+func NewRecorder() Recorder {
+	return &ring{}
+}
+
+// RecordAWSCall appends rec to the AWS call buffer, dropping the oldest
+// entry once ringSize is exceeded.
+func (r *ring) RecordAWSCall(rec AWSCallRecord) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.awsCalls = append(r.awsCalls, rec)
+	if len(r.awsCalls) > ringSize {
+		r.awsCalls = r.awsCalls[len(r.awsCalls)-ringSize:]
+	}
+}
+
+// RecordReconcile appends rec to the reconcile buffer, dropping the oldest
+// entry once ringSize is exceeded.
+func (r *ring) RecordReconcile(rec ReconcileRecord) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reconciles = append(r.reconciles, rec)
+	if len(r.reconciles) > ringSize {
+		r.reconciles = r.reconciles[len(r.reconciles)-ringSize:]
+	}
+}
+
+// AWSCalls returns a snapshot of the recorded AWS calls, oldest first.
+func (r *ring) AWSCalls() []AWSCallRecord {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make([]AWSCallRecord, len(r.awsCalls))
+	copy(out, r.awsCalls)
+	return out
+}
+
+// Reconciles returns a snapshot of the recorded reconciles, oldest first.
+func (r *ring) Reconciles() []ReconcileRecord {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make([]ReconcileRecord, len(r.reconciles))
+	copy(out, r.reconciles)
+	return out
+}
+
+// Default is the process-wide Recorder controllers push to when no other
+// Recorder has been wired in. Tests should construct their own Recorder
+// with NewRecorder rather than relying on this global.
+var Default = NewRecorder()
@@ -0,0 +1,72 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRingBoundsAWSCalls(t *testing.T) {
+	r := NewRecorder()
+
+	for i := 0; i < ringSize+10; i++ {
+		r.RecordAWSCall(AWSCallRecord{Operation: fmt.Sprintf("op-%d", i)})
+	}
+
+	got := r.AWSCalls()
+	if len(got) != ringSize {
+		t.Fatalf("AWSCalls(): len = %d, want %d", len(got), ringSize)
+	}
+	if got[0].Operation != "op-10" {
+		t.Errorf("AWSCalls()[0].Operation = %q, want %q (oldest entries must be dropped first)", got[0].Operation, "op-10")
+	}
+	if last := got[len(got)-1].Operation; last != fmt.Sprintf("op-%d", ringSize+9) {
+		t.Errorf("AWSCalls()[last].Operation = %q, want %q", last, fmt.Sprintf("op-%d", ringSize+9))
+	}
+}
+
+func TestRingBoundsReconciles(t *testing.T) {
+	r := NewRecorder()
+
+	for i := 0; i < ringSize+10; i++ {
+		r.RecordReconcile(ReconcileRecord{Name: fmt.Sprintf("res-%d", i)})
+	}
+
+	got := r.Reconciles()
+	if len(got) != ringSize {
+		t.Fatalf("Reconciles(): len = %d, want %d", len(got), ringSize)
+	}
+	if got[0].Name != "res-10" {
+		t.Errorf("Reconciles()[0].Name = %q, want %q (oldest entries must be dropped first)", got[0].Name, "res-10")
+	}
+}
+
+func TestRingSnapshotIsIndependent(t *testing.T) {
+	r := NewRecorder()
+	r.RecordAWSCall(AWSCallRecord{Operation: "first"})
+
+	snapshot := r.AWSCalls()
+	r.RecordAWSCall(AWSCallRecord{Operation: "second"})
+
+	want := []AWSCallRecord{{Operation: "first"}}
+	if diff := cmp.Diff(want, snapshot); diff != "" {
+		t.Errorf("AWSCalls() snapshot mutated by later writes: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A ManagedSummary is a point-in-time summary of a managed resource's
+// last observation, suitable for an admin diagnostics dump.
+type ManagedSummary struct {
+	Kind       string           `json:"kind"`
+	Name       string           `json:"name"`
+	Conditions []xpv1.Condition `json:"conditions"`
+}
+
+// ManagedLister returns a summary of every managed resource known to the
+// provider. It is supplied by the caller of Serve so this package does not
+// need to depend on a concrete client implementation.
+type ManagedLister func() []ManagedSummary
+
+// NewHandler returns the http.Handler backing the admin diagnostics
+// endpoints: /debug/managed, /debug/aws-calls and /debug/reconciles.
+func NewHandler(managed ManagedLister, rec Recorder) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/debug/managed", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, managed())
+	})
+	mux.HandleFunc("/debug/aws-calls", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, rec.AWSCalls())
+	})
+	mux.HandleFunc("/debug/reconciles", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, rec.Reconciles())
+	})
+
+	return mux
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// Serve starts the admin diagnostics HTTP server on addr, blocking until
+// ctx is cancelled. It is a no-op unless enabled is true, so the
+// diagnostics subsystem stays off by default and must be opted into with
+// a command line flag. See cmd/provider for the --debug-endpoint and
+// --debug-addr flags that control it.
+func Serve(ctx context.Context, enabled bool, addr string, managed ManagedLister, rec Recorder) error {
+	if !enabled {
+		return nil
+	}
+
+	srv := &http.Server{Addr: addr, Handler: NewHandler(managed, rec)}
+
+	go func() {
+		<-ctx.Done()
+		_ = srv.Shutdown(context.Background())
+	}()
+
+	if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
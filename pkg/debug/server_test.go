@@ -0,0 +1,85 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHandlerManaged(t *testing.T) {
+	want := []ManagedSummary{{Kind: "CacheSubnetGroup", Name: "my-group"}}
+	h := NewHandler(func() []ManagedSummary { return want }, NewRecorder())
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/managed", nil))
+
+	var got []ManagedSummary
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("json.Unmarshal(...): %v", err)
+	}
+	if len(got) != 1 || got[0].Name != "my-group" {
+		t.Errorf("GET /debug/managed: got %+v, want %+v", got, want)
+	}
+}
+
+func TestHandlerAWSCalls(t *testing.T) {
+	r := NewRecorder()
+	r.RecordAWSCall(AWSCallRecord{Controller: "cachesubnetgroup", Operation: "DescribeCacheSubnetGroups"})
+	h := NewHandler(func() []ManagedSummary { return nil }, r)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/aws-calls", nil))
+
+	var got []AWSCallRecord
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("json.Unmarshal(...): %v", err)
+	}
+	if len(got) != 1 || got[0].Operation != "DescribeCacheSubnetGroups" {
+		t.Errorf("GET /debug/aws-calls: got %+v, want 1 record for DescribeCacheSubnetGroups", got)
+	}
+}
+
+func TestHandlerReconciles(t *testing.T) {
+	r := NewRecorder()
+	r.RecordReconcile(ReconcileRecord{Controller: "cachesubnetgroup", Name: "my-group"})
+	h := NewHandler(func() []ManagedSummary { return nil }, r)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/reconciles", nil))
+
+	var got []ReconcileRecord
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("json.Unmarshal(...): %v", err)
+	}
+	if len(got) != 1 || got[0].Name != "my-group" {
+		t.Errorf("GET /debug/reconciles: got %+v, want 1 record for my-group", got)
+	}
+}
+
+func TestServeDisabledIsNoOp(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if err := Serve(ctx, false, "127.0.0.1:0", func() []ManagedSummary { return nil }, NewRecorder()); err != nil {
+		t.Errorf("Serve(enabled=false): unexpected error: %v", err)
+	}
+}